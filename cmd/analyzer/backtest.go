@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Jay2006sawant/go-security-renovate-demo/internal/analyzer"
+	"github.com/spf13/cobra"
+)
+
+func newBacktestCmd() *cobra.Command {
+	backtestCmd := &cobra.Command{
+		Use:   "backtest",
+		Short: "Evaluate a repository against advisories known at a past date",
+		Long: `Evaluate the repository's dependencies as they were at a given date against
+only the advisories published by then. Useful for incident retrospectives:
+"would we have caught this then?"`,
+		RunE: runBacktest,
+	}
+
+	backtestCmd.Flags().StringP("repo", "r", "", "Repository URL to analyze (required)")
+	backtestCmd.Flags().String("as-of", "", "Date to evaluate at, in YYYY-MM-DD format (required)")
+	backtestCmd.Flags().StringP("output", "o", "console", "Output format: console, json")
+	backtestCmd.MarkFlagRequired("repo")
+	backtestCmd.MarkFlagRequired("as-of")
+
+	return backtestCmd
+}
+
+func runBacktest(cmd *cobra.Command, args []string) error {
+	repoURL, _ := cmd.Flags().GetString("repo")
+	asOfValue, _ := cmd.Flags().GetString("as-of")
+	outputFormat, _ := cmd.Flags().GetString("output")
+
+	asOf, err := time.Parse("2006-01-02", asOfValue)
+	if err != nil {
+		return fmt.Errorf("invalid --as-of date %q: expected YYYY-MM-DD", asOfValue)
+	}
+	// Include everything published during the given day
+	asOf = asOf.Add(24*time.Hour - time.Second)
+
+	fmt.Printf("%s Backtesting %s as of %s\n", blue("ℹ"), repoURL, asOfValue)
+
+	gitAnalyzer := analyzer.NewGitAnalyzer()
+	report, err := gitAnalyzer.Backtest(repoURL, asOf)
+	if err != nil {
+		return fmt.Errorf("failed to backtest repository: %w", err)
+	}
+
+	if outputFormat == "json" {
+		return report.OutputJSON()
+	}
+
+	return report.OutputConsole()
+}
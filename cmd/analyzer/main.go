@@ -4,14 +4,14 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/Jay2006sawant/go-security-renovate-demo/internal/analyzer"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
-	"github.com/Jay2006sawant/go-security-renovate-demo/internal/analyzer"
 )
 
 var (
 	version = "1.0.0"
-	
+
 	// Color functions for enhanced output
 	red    = color.New(color.FgRed, color.Bold).SprintFunc()
 	green  = color.New(color.FgGreen, color.Bold).SprintFunc()
@@ -20,7 +20,7 @@ var (
 )
 
 func main() {
-	rootCmd := &cobra.Command{ 
+	rootCmd := &cobra.Command{
 		Use:   "analyzer",
 		Short: "Git Repository Security Analyzer",
 		Long: `A demonstration tool that analyzes Git repositories for security insights.
@@ -39,6 +39,8 @@ can help maintain secure dependencies in Go projects.`,
 	analyzeCmd.Flags().StringP("repo", "r", "", "Repository URL to analyze (required)")
 	analyzeCmd.Flags().StringP("output", "o", "console", "Output format: console, json")
 	analyzeCmd.Flags().BoolP("verbose", "v", false, "Enable verbose output")
+	analyzeCmd.Flags().String("golden", "", "Golden baseline to compare against: spec JSON file or template repository URL")
+	analyzeCmd.Flags().String("github-token", os.Getenv("GITHUB_TOKEN"), "GitHub token for API checks such as branch protection")
 	analyzeCmd.MarkFlagRequired("repo")
 
 	demoCmd := &cobra.Command{
@@ -55,7 +57,7 @@ can help maintain secure dependencies in Go projects.`,
 		RunE:  showVulnerability,
 	}
 
-	rootCmd.AddCommand(analyzeCmd, demoCmd, vulnerabilityCmd)
+	rootCmd.AddCommand(analyzeCmd, demoCmd, vulnerabilityCmd, newBacktestCmd())
 
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintf(os.Stderr, "%s Error: %v\n", red("✗"), err)
@@ -67,15 +69,27 @@ func runAnalyze(cmd *cobra.Command, args []string) error {
 	repoURL, _ := cmd.Flags().GetString("repo")
 	outputFormat, _ := cmd.Flags().GetString("output")
 	verbose, _ := cmd.Flags().GetBool("verbose")
+	goldenSource, _ := cmd.Flags().GetString("golden")
+	githubToken, _ := cmd.Flags().GetString("github-token")
 
 	fmt.Printf("%s Starting analysis of repository: %s\n", blue("ℹ"), repoURL)
-	
+
 	if verbose {
 		fmt.Printf("%s Using vulnerable go-git version for demonstration\n", yellow("⚠"))
 	}
 
 	gitAnalyzer := analyzer.NewGitAnalyzer()
-	report, err := gitAnalyzer.AnalyzeRepository(repoURL)
+	opts := analyzer.Options{GitHubToken: githubToken}
+
+	if goldenSource != "" {
+		spec, err := gitAnalyzer.LoadGoldenSpec(goldenSource)
+		if err != nil {
+			return fmt.Errorf("failed to load golden baseline: %w", err)
+		}
+		opts.Golden = spec
+	}
+
+	report, err := gitAnalyzer.AnalyzeRepository(repoURL, opts)
 	if err != nil {
 		return fmt.Errorf("failed to analyze repository: %w", err)
 	}
@@ -83,13 +97,13 @@ func runAnalyze(cmd *cobra.Command, args []string) error {
 	if outputFormat == "json" {
 		return report.OutputJSON()
 	}
-	
+
 	return report.OutputConsole()
 }
 
 func runDemo(cmd *cobra.Command, args []string) error {
 	fmt.Printf("%s Running demo analysis with sample repositories\n", green("✓"))
-	
+
 	sampleRepos := []string{
 		"https://github.com/go-git/go-git",
 		"https://github.com/spf13/cobra",
@@ -97,19 +111,19 @@ func runDemo(cmd *cobra.Command, args []string) error {
 	}
 
 	gitAnalyzer := analyzer.NewGitAnalyzer()
-	
+
 	for i, repo := range sampleRepos {
 		fmt.Printf("\n%s [%d/%d] Analyzing: %s\n", blue("→"), i+1, len(sampleRepos), repo)
-		
-		report, err := gitAnalyzer.AnalyzeRepository(repo)
+
+		report, err := gitAnalyzer.AnalyzeRepository(repo, analyzer.Options{})
 		if err != nil {
 			fmt.Printf("%s Failed to analyze %s: %v\n", red("✗"), repo, err)
 			continue
 		}
-		
+
 		report.OutputConsole()
 	}
-	
+
 	return nil
 }
 
@@ -137,6 +151,6 @@ func showVulnerability(cmd *cobra.Command, args []string) error {
 	fmt.Println("  updates with tools like Renovate are crucial for security.")
 	fmt.Println()
 	fmt.Printf("%s Reference: https://cve.mitre.org/cgi-bin/cvename.cgi?name=CVE-2023-49568\n", blue("🔗"))
-	
+
 	return nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,199 @@
+package analyzer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/Jay2006sawant/go-security-renovate-demo/internal/deps"
+	"github.com/Jay2006sawant/go-security-renovate-demo/internal/vulndb"
+)
+
+// BacktestReport describes which advisories would have been reported for a
+// repository at a point in time, using only advisories published by then
+type BacktestReport struct {
+	URL          string            `json:"url"`
+	AsOf         time.Time         `json:"as_of"`
+	CommitHash   string            `json:"commit_hash"`
+	CommitDate   time.Time         `json:"commit_date"`
+	Dependencies []deps.Module     `json:"dependencies"`
+	Known        []BacktestFinding `json:"known_at_date"`
+	LaterKnown   []BacktestFinding `json:"published_later"`
+}
+
+// BacktestFinding is an advisory matched against a dependency during a backtest
+type BacktestFinding struct {
+	ID           string    `json:"id"`
+	CVE          string    `json:"cve,omitempty"`
+	Module       string    `json:"module"`
+	Version      string    `json:"version"`
+	FixedVersion string    `json:"fixed_version,omitempty"`
+	Published    time.Time `json:"published"`
+	Summary      string    `json:"summary"`
+}
+
+// Backtest evaluates the repository's dependencies as they were at asOf against
+// advisories published on or before that date. Advisories published later are
+// listed separately so retrospectives can see what could not have been caught.
+func (ga *GitAnalyzer) Backtest(repoURL string, asOf time.Time) (*BacktestReport, error) {
+	// Full history is needed to find the commit that was current at asOf
+	repo, cloneDir, err := ga.cloneRepository(repoURL, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(cloneDir)
+
+	commit, err := commitAsOf(repo, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &BacktestReport{
+		URL:          repoURL,
+		AsOf:         asOf,
+		CommitHash:   commit.Hash.String(),
+		CommitDate:   commit.Committer.When,
+		Dependencies: []deps.Module{},
+		Known:        []BacktestFinding{},
+		LaterKnown:   []BacktestFinding{},
+	}
+
+	file, err := commit.File("go.mod")
+	if err != nil {
+		// Nothing to evaluate without a module file at that point in history
+		return report, nil
+	}
+	contents, err := file.Contents()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read go.mod at %s: %w", commit.Hash, err)
+	}
+	modules, err := deps.ParseGoMod("go.mod", []byte(contents))
+	if err != nil {
+		return nil, err
+	}
+	report.Dependencies = modules
+
+	client := vulndb.NewClient()
+	for _, module := range modules {
+		vulns, err := client.Query(module.Path, module.Version)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, vuln := range vulns {
+			finding := BacktestFinding{
+				ID:           vuln.ID,
+				CVE:          vuln.CVE(),
+				Module:       module.Path,
+				Version:      module.Version,
+				FixedVersion: vuln.FixedVersion(module.Path),
+				Published:    vuln.Published,
+				Summary:      vuln.Summary,
+			}
+			if vuln.Published.After(asOf) {
+				report.LaterKnown = append(report.LaterKnown, finding)
+			} else {
+				report.Known = append(report.Known, finding)
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// commitAsOf returns the newest commit on HEAD committed on or before the given time
+func commitAsOf(repo *git.Repository, asOf time.Time) (*object.Commit, error) {
+	ref, err := repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get HEAD reference: %w", err)
+	}
+
+	commitIter, err := repo.Log(&git.LogOptions{From: ref.Hash(), Order: git.LogOrderCommitterTime})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk history: %w", err)
+	}
+	defer commitIter.Close()
+
+	for {
+		commit, err := commitIter.Next()
+		if err == io.EOF {
+			return nil, fmt.Errorf("repository has no commits on or before %s", asOf.Format("2006-01-02"))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to walk history: %w", err)
+		}
+		if !commit.Committer.When.After(asOf) {
+			return commit, nil
+		}
+	}
+}
+
+// OutputConsole prints the backtest report to console with colored output
+func (b *BacktestReport) OutputConsole() error {
+	red := color.New(color.FgRed, color.Bold).SprintFunc()
+	green := color.New(color.FgGreen, color.Bold).SprintFunc()
+	yellow := color.New(color.FgYellow, color.Bold).SprintFunc()
+	blue := color.New(color.FgBlue, color.Bold).SprintFunc()
+
+	fmt.Println()
+	fmt.Printf("%s Advisory Backtest Report\n", blue("⏪"))
+	fmt.Printf("%s %s\n", blue("═"), strings.Repeat("═", 50))
+	fmt.Println()
+	fmt.Printf("   URL: %s\n", b.URL)
+	fmt.Printf("   As of: %s\n", b.AsOf.Format("2006-01-02"))
+	fmt.Printf("   Commit: %s (%s)\n", b.CommitHash[:12]+"...", b.CommitDate.Format("2006-01-02 15:04:05"))
+	fmt.Printf("   Dependencies evaluated: %s\n", green(fmt.Sprintf("%d", len(b.Dependencies))))
+	fmt.Println()
+
+	if len(b.Known) == 0 {
+		fmt.Printf("%s No advisories were published for these dependencies by that date\n", green("✅"))
+	} else {
+		fmt.Printf("%s Would have been caught (%d)\n", red("🚨"), len(b.Known))
+		for _, finding := range b.Known {
+			printBacktestFinding(finding)
+		}
+	}
+	fmt.Println()
+
+	if len(b.LaterKnown) > 0 {
+		fmt.Printf("%s Published after the date (%d)\n", yellow("⏳"), len(b.LaterKnown))
+		for _, finding := range b.LaterKnown {
+			printBacktestFinding(finding)
+		}
+		fmt.Println()
+	}
+
+	return nil
+}
+
+// OutputJSON prints the backtest report as JSON
+func (b *BacktestReport) OutputJSON() error {
+	jsonData, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal backtest report to JSON: %w", err)
+	}
+
+	fmt.Println(string(jsonData))
+	return nil
+}
+
+func printBacktestFinding(finding BacktestFinding) {
+	id := finding.ID
+	if finding.CVE != "" && finding.CVE != finding.ID {
+		id = fmt.Sprintf("%s (%s)", finding.ID, finding.CVE)
+	}
+	fmt.Printf("   • %s %s@%s published %s\n", id, finding.Module, finding.Version, finding.Published.Format("2006-01-02"))
+	if finding.Summary != "" {
+		fmt.Printf("     %s\n", finding.Summary)
+	}
+	if finding.FixedVersion != "" {
+		fmt.Printf("     Fixed in: %s\n", finding.FixedVersion)
+	}
+}
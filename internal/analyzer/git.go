@@ -10,6 +10,8 @@ import (
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/Jay2006sawant/go-security-renovate-demo/internal/golden"
 )
 
 // GitAnalyzer handles Git repository analysis using the vulnerable go-git library
@@ -19,26 +21,35 @@ type GitAnalyzer struct {
 
 // RepositoryInfo contains information about the analyzed repository
 type RepositoryInfo struct {
-	URL               string    `json:"url"`
-	LastCommitHash    string    `json:"last_commit_hash"`
-	LastCommitDate    time.Time `json:"last_commit_date"`
-	LastCommitAuthor  string    `json:"last_commit_author"`
-	LastCommitMsg     string    `json:"last_commit_message"`
-	BranchCount       int       `json:"branch_count"`
-	CommitCount       int       `json:"commit_count"`
-	Contributors      []string  `json:"contributors"`
-	Languages         []string  `json:"languages"`
-	VulnerabilityInfo VulnInfo  `json:"vulnerability_info"`
+	URL               string        `json:"url"`
+	LastCommitHash    string        `json:"last_commit_hash"`
+	LastCommitDate    time.Time     `json:"last_commit_date"`
+	LastCommitAuthor  string        `json:"last_commit_author"`
+	LastCommitMsg     string        `json:"last_commit_message"`
+	BranchCount       int           `json:"branch_count"`
+	CommitCount       int           `json:"commit_count"`
+	Contributors      []string      `json:"contributors"`
+	Languages         []string      `json:"languages"`
+	VulnerabilityInfo VulnInfo      `json:"vulnerability_info"`
+	GoldenDrift       *golden.Drift `json:"golden_drift,omitempty"`
 }
 
 // VulnInfo contains information about the vulnerability being demonstrated
 type VulnInfo struct {
-	CVE           string `json:"cve"`
-	Severity      string `json:"severity"`
-	AffectedLib   string `json:"affected_library"`
-	CurrentVer    string `json:"current_version"`
-	FixedInVer    string `json:"fixed_in_version"`
-	Description   string `json:"description"`
+	CVE         string `json:"cve"`
+	Severity    string `json:"severity"`
+	AffectedLib string `json:"affected_library"`
+	CurrentVer  string `json:"current_version"`
+	FixedInVer  string `json:"fixed_in_version"`
+	Description string `json:"description"`
+}
+
+// Options controls the optional analysis steps performed on a repository
+type Options struct {
+	// Golden is the organizational baseline the repository is compared against
+	Golden *golden.Spec
+	// GitHubToken is used for GitHub API lookups such as branch protection
+	GitHubToken string
 }
 
 // NewGitAnalyzer creates a new GitAnalyzer instance
@@ -51,30 +62,17 @@ func NewGitAnalyzer() *GitAnalyzer {
 // AnalyzeRepository clones and analyzes a Git repository
 // This method uses the VULNERABLE go-git library version 5.4.2
 // which is susceptible to CVE-2023-49568 (path traversal vulnerability)
-func (ga *GitAnalyzer) AnalyzeRepository(repoURL string) (*Report, error) {
-	// Create temporary directory for cloning
-	cloneDir := filepath.Join(ga.tempDir, fmt.Sprintf("repo-%d", time.Now().Unix()))
+func (ga *GitAnalyzer) AnalyzeRepository(repoURL string, opts Options) (*Report, error) {
+	repo, cloneDir, err := ga.cloneRepository(repoURL, 50)
+	if err != nil {
+		return nil, err
+	}
 
 	// Clean up clone directory when done
 	defer func() {
 		os.RemoveAll(cloneDir)
 	}()
 
-	fmt.Printf("🔄 Cloning repository (using VULNERABLE go-git v5.4.2)...\n")
-
-	// Clone repository using vulnerable go-git library
-	// CVE-2023-49568: This version is vulnerable to path traversal attacks
-	repo, err := git.PlainClone(cloneDir, false, &git.CloneOptions{
-		URL:      repoURL,
-		Progress: nil, // Suppress progress for cleaner output
-		Depth:    50,  // Shallow clone for faster analysis
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to clone repository: %w", err)
-	}
-
-	fmt.Printf("✅ Repository cloned successfully\n")
-
 	// Analyze repository structure and commits
 	repoInfo, err := ga.analyzeRepoStructure(repo, repoURL)
 	if err != nil {
@@ -88,6 +86,11 @@ func (ga *GitAnalyzer) AnalyzeRepository(repoURL string) (*Report, error) {
 	}
 	repoInfo.Languages = languages
 
+	// Compare against the organizational golden baseline
+	if opts.Golden != nil {
+		repoInfo.GoldenDrift = golden.Compare(opts.Golden, cloneDir, repoURL, opts.GitHubToken)
+	}
+
 	// Create vulnerability information
 	repoInfo.VulnerabilityInfo = VulnInfo{
 		CVE:         "CVE-2023-49568",
@@ -101,6 +104,48 @@ func (ga *GitAnalyzer) AnalyzeRepository(repoURL string) (*Report, error) {
 	return NewReport(repoInfo), nil
 }
 
+// LoadGoldenSpec loads a golden baseline from a JSON spec file or, when given
+// a repository URL, by cloning the template repository and deriving the spec
+func (ga *GitAnalyzer) LoadGoldenSpec(source string) (*golden.Spec, error) {
+	if _, err := os.Stat(source); err == nil {
+		return golden.LoadSpec(source)
+	}
+
+	_, cloneDir, err := ga.cloneRepository(source, 1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone golden template: %w", err)
+	}
+	defer os.RemoveAll(cloneDir)
+
+	return golden.DeriveSpec(cloneDir, source)
+}
+
+// cloneRepository clones a repository into a fresh temporary directory.
+// A depth of 0 clones the full history. The caller is responsible for
+// removing the returned directory.
+func (ga *GitAnalyzer) cloneRepository(repoURL string, depth int) (*git.Repository, string, error) {
+	// Create temporary directory for cloning
+	cloneDir := filepath.Join(ga.tempDir, fmt.Sprintf("repo-%d", time.Now().UnixNano()))
+
+	fmt.Printf("🔄 Cloning repository (using VULNERABLE go-git v5.4.2)...\n")
+
+	// Clone repository using vulnerable go-git library
+	// CVE-2023-49568: This version is vulnerable to path traversal attacks
+	repo, err := git.PlainClone(cloneDir, false, &git.CloneOptions{
+		URL:      repoURL,
+		Progress: nil,   // Suppress progress for cleaner output
+		Depth:    depth, // Shallow clone for faster analysis
+	})
+	if err != nil {
+		os.RemoveAll(cloneDir)
+		return nil, "", fmt.Errorf("failed to clone repository: %w", err)
+	}
+
+	fmt.Printf("✅ Repository cloned successfully\n")
+
+	return repo, cloneDir, nil
+}
+
 // analyzeRepoStructure extracts information from the Git repository
 func (ga *GitAnalyzer) analyzeRepoStructure(repo *git.Repository, repoURL string) (*RepositoryInfo, error) {
 	info := &RepositoryInfo{
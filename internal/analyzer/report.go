@@ -93,11 +93,33 @@ func (r *Report) OutputConsole() error {
 		fmt.Println()
 	}
 
+	// Golden Baseline Drift
+	if drift := r.RepoInfo.GoldenDrift; drift != nil {
+		fmt.Printf("%s Golden Baseline Comparison\n", cyan("📐"))
+		fmt.Printf("   Baseline: %s\n", drift.Source)
+		if !drift.HasDrift() {
+			fmt.Printf("   %s\n", green("No drift from the golden baseline"))
+		}
+		for _, file := range drift.MissingFiles {
+			fmt.Printf("   %s Missing required file: %s\n", red("✗"), file)
+		}
+		for _, key := range drift.MissingRenovateKeys {
+			fmt.Printf("   %s Missing Renovate key: %s\n", red("✗"), key)
+		}
+		for _, branch := range drift.UnprotectedBranches {
+			fmt.Printf("   %s Branch not protected: %s\n", red("✗"), branch)
+		}
+		for _, branch := range drift.UnverifiedProtection {
+			fmt.Printf("   %s Could not verify protection of branch: %s\n", yellow("?"), branch)
+		}
+		fmt.Println()
+	}
+
 	// Vulnerability Information (The key part of this demo)
 	fmt.Printf("%s SECURITY VULNERABILITY DEMONSTRATION\n", red("🚨"))
 	fmt.Printf("%s %s\n", red("═"), strings.Repeat("═", 50))
 	fmt.Println()
-	
+
 	vuln := r.RepoInfo.VulnerabilityInfo
 	fmt.Printf("%s Vulnerability: %s\n", red("🔒"), red(vuln.CVE))
 	fmt.Printf("%s Severity: %s\n", red("⚠"), red(vuln.Severity))
@@ -105,7 +127,7 @@ func (r *Report) OutputConsole() error {
 	fmt.Printf("%s Current Version: %s %s\n", red("🔴"), vuln.CurrentVer, red("(VULNERABLE)"))
 	fmt.Printf("%s Fixed in Version: %s %s\n", green("🟢"), vuln.FixedInVer, green("(SECURE)"))
 	fmt.Println()
-	
+
 	fmt.Printf("%s Description:\n", blue("📋"))
 	fmt.Printf("   %s\n", vuln.Description)
 	fmt.Println()
@@ -181,4 +203,4 @@ func (r *Report) SaveToFile(filename string, format string) error {
 	default:
 		return fmt.Errorf("unsupported format: %s", format)
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,33 @@
+package deps
+
+import (
+	"fmt"
+
+	"golang.org/x/mod/modfile"
+)
+
+// Module is a Go module dependency declared in a go.mod file
+type Module struct {
+	Path     string `json:"path"`
+	Version  string `json:"version"`
+	Indirect bool   `json:"indirect"`
+}
+
+// ParseGoMod parses the contents of a go.mod file and returns its required modules
+func ParseGoMod(filename string, data []byte) ([]Module, error) {
+	file, err := modfile.ParseLax(filename, data, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", filename, err)
+	}
+
+	modules := make([]Module, 0, len(file.Require))
+	for _, req := range file.Require {
+		modules = append(modules, Module{
+			Path:     req.Mod.Path,
+			Version:  req.Mod.Version,
+			Indirect: req.Indirect,
+		})
+	}
+
+	return modules, nil
+}
@@ -0,0 +1,224 @@
+package golden
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Spec describes the organizational "golden" baseline that analyzed repositories are compared against
+type Spec struct {
+	Source                   string   `json:"source,omitempty"`
+	RequiredFiles            []string `json:"required_files"`
+	RequiredRenovateKeys     []string `json:"required_renovate_keys"`
+	RequiredBranchProtection []string `json:"required_branch_protection"`
+}
+
+// Drift describes how an analyzed repository differs from the golden baseline
+type Drift struct {
+	Source               string   `json:"source"`
+	MissingFiles         []string `json:"missing_files"`
+	MissingRenovateKeys  []string `json:"missing_renovate_keys"`
+	UnprotectedBranches  []string `json:"unprotected_branches"`
+	UnverifiedProtection []string `json:"unverified_branch_protection,omitempty"`
+	RenovateConfigPath   string   `json:"renovate_config_path,omitempty"`
+}
+
+// specFileName is the optional file a template repository can commit to declare
+// requirements that cannot be derived from its tree (e.g. branch protection)
+const specFileName = ".golden.json"
+
+// renovateConfigPaths lists the locations Renovate reads its configuration from
+var renovateConfigPaths = []string{
+	"renovate.json",
+	"renovate.json5",
+	".github/renovate.json",
+	".github/renovate.json5",
+	".gitlab/renovate.json",
+	".renovaterc",
+	".renovaterc.json",
+}
+
+// LoadSpec reads a golden baseline specification from a JSON file
+func LoadSpec(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read golden spec %s: %w", path, err)
+	}
+
+	spec := &Spec{}
+	if err := json.Unmarshal(data, spec); err != nil {
+		return nil, fmt.Errorf("failed to parse golden spec %s: %w", path, err)
+	}
+	if spec.Source == "" {
+		spec.Source = path
+	}
+
+	return spec, nil
+}
+
+// DeriveSpec builds a golden baseline from a checked-out template repository.
+// Workflow files and Renovate configuration keys are taken from the tree, and
+// an optional .golden.json file is merged in for the remaining requirements.
+func DeriveSpec(templateDir, source string) (*Spec, error) {
+	spec := &Spec{Source: source}
+
+	if data, err := os.ReadFile(filepath.Join(templateDir, specFileName)); err == nil {
+		if err := json.Unmarshal(data, spec); err != nil {
+			return nil, fmt.Errorf("failed to parse %s in template: %w", specFileName, err)
+		}
+		spec.Source = source
+	}
+
+	workflows, err := filepath.Glob(filepath.Join(templateDir, ".github", "workflows", "*.y*ml"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list template workflows: %w", err)
+	}
+	for _, workflow := range workflows {
+		rel, _ := filepath.Rel(templateDir, workflow)
+		spec.RequiredFiles = appendUnique(spec.RequiredFiles, filepath.ToSlash(rel))
+	}
+
+	if _, config, err := readRenovateConfig(templateDir); err == nil && config != nil {
+		for key := range config {
+			spec.RequiredRenovateKeys = appendUnique(spec.RequiredRenovateKeys, key)
+		}
+	}
+
+	sort.Strings(spec.RequiredFiles)
+	sort.Strings(spec.RequiredRenovateKeys)
+
+	return spec, nil
+}
+
+// Compare checks a checked-out repository against the golden baseline.
+// Branch protection can only be verified through the GitHub API, so it is
+// checked when repoURL points at GitHub and a token is available.
+func Compare(spec *Spec, repoDir, repoURL, token string) *Drift {
+	drift := &Drift{
+		Source:              spec.Source,
+		MissingFiles:        []string{},
+		MissingRenovateKeys: []string{},
+		UnprotectedBranches: []string{},
+	}
+
+	for _, file := range spec.RequiredFiles {
+		if _, err := os.Stat(filepath.Join(repoDir, filepath.FromSlash(file))); err != nil {
+			drift.MissingFiles = append(drift.MissingFiles, file)
+		}
+	}
+
+	if len(spec.RequiredRenovateKeys) > 0 {
+		path, config, err := readRenovateConfig(repoDir)
+		drift.RenovateConfigPath = path
+		for _, key := range spec.RequiredRenovateKeys {
+			if err != nil || config == nil {
+				drift.MissingRenovateKeys = append(drift.MissingRenovateKeys, key)
+				continue
+			}
+			if _, exists := config[key]; !exists {
+				drift.MissingRenovateKeys = append(drift.MissingRenovateKeys, key)
+			}
+		}
+	}
+
+	for _, branch := range spec.RequiredBranchProtection {
+		protected, err := branchProtected(repoURL, branch, token)
+		if err != nil {
+			drift.UnverifiedProtection = append(drift.UnverifiedProtection, branch)
+			continue
+		}
+		if !protected {
+			drift.UnprotectedBranches = append(drift.UnprotectedBranches, branch)
+		}
+	}
+
+	return drift
+}
+
+// HasDrift reports whether the repository deviates from the golden baseline
+func (d *Drift) HasDrift() bool {
+	return len(d.MissingFiles) > 0 || len(d.MissingRenovateKeys) > 0 || len(d.UnprotectedBranches) > 0
+}
+
+// readRenovateConfig returns the first Renovate configuration found in the tree.
+// JSON5 files are parsed as plain JSON, which covers configs without comments.
+func readRenovateConfig(dir string) (string, map[string]interface{}, error) {
+	for _, candidate := range renovateConfigPaths {
+		data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(candidate)))
+		if err != nil {
+			continue
+		}
+
+		config := make(map[string]interface{})
+		if err := json.Unmarshal(data, &config); err != nil {
+			return candidate, nil, fmt.Errorf("failed to parse %s: %w", candidate, err)
+		}
+		return candidate, config, nil
+	}
+
+	return "", nil, fmt.Errorf("no renovate configuration found")
+}
+
+// branchProtected queries the GitHub API for the protection status of a branch
+func branchProtected(repoURL, branch, token string) (bool, error) {
+	owner, name, ok := githubRepo(repoURL)
+	if !ok {
+		return false, fmt.Errorf("branch protection can only be checked for GitHub repositories")
+	}
+	if token == "" {
+		return false, fmt.Errorf("a GitHub token is required to check branch protection")
+	}
+
+	url := fmt.Sprintf("https://api.github.com/repos/%s/%s/branches/%s/protection", owner, name, branch)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	client := &http.Client{Timeout: 15 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("failed to query branch protection: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("unexpected status from GitHub API: %s", resp.Status)
+	}
+}
+
+// githubRepo extracts the owner and repository name from a GitHub URL
+func githubRepo(repoURL string) (string, string, bool) {
+	trimmed := strings.TrimSuffix(strings.TrimSuffix(repoURL, "/"), ".git")
+	for _, prefix := range []string{"https://github.com/", "http://github.com/", "git@github.com:"} {
+		if strings.HasPrefix(trimmed, prefix) {
+			parts := strings.Split(strings.TrimPrefix(trimmed, prefix), "/")
+			if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+				return parts[0], parts[1], true
+			}
+		}
+	}
+	return "", "", false
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, existing := range values {
+		if existing == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
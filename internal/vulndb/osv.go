@@ -0,0 +1,137 @@
+package vulndb
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultOSVEndpoint is the public OSV.dev API
+const DefaultOSVEndpoint = "https://api.osv.dev/v1"
+
+// Client queries the OSV vulnerability database
+type Client struct {
+	endpoint   string
+	httpClient *http.Client
+}
+
+// Vulnerability is an OSV advisory as returned by the OSV API
+type Vulnerability struct {
+	ID         string      `json:"id"`
+	Summary    string      `json:"summary,omitempty"`
+	Details    string      `json:"details,omitempty"`
+	Aliases    []string    `json:"aliases,omitempty"`
+	Published  time.Time   `json:"published"`
+	Modified   time.Time   `json:"modified"`
+	Severity   []Severity  `json:"severity,omitempty"`
+	Affected   []Affected  `json:"affected,omitempty"`
+	References []Reference `json:"references,omitempty"`
+}
+
+// Severity is a scored severity rating such as a CVSS vector
+type Severity struct {
+	Type  string `json:"type"`
+	Score string `json:"score"`
+}
+
+// Affected describes a package and the version ranges affected by an advisory
+type Affected struct {
+	Package Package `json:"package"`
+	Ranges  []Range `json:"ranges,omitempty"`
+}
+
+// Package identifies a package within an ecosystem
+type Package struct {
+	Name      string `json:"name"`
+	Ecosystem string `json:"ecosystem"`
+}
+
+// Range is a list of version events describing affected versions
+type Range struct {
+	Type   string  `json:"type"`
+	Events []Event `json:"events"`
+}
+
+// Event marks a version where a vulnerability was introduced or fixed
+type Event struct {
+	Introduced   string `json:"introduced,omitempty"`
+	Fixed        string `json:"fixed,omitempty"`
+	LastAffected string `json:"last_affected,omitempty"`
+}
+
+// Reference is a link to further information about an advisory
+type Reference struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+// NewClient creates a new OSV client using the public endpoint
+func NewClient() *Client {
+	return &Client{
+		endpoint:   DefaultOSVEndpoint,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Query returns the advisories affecting a Go module at the given version
+func (c *Client) Query(module, version string) ([]Vulnerability, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"version": version,
+		"package": Package{Name: module, Ecosystem: "Go"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode OSV query: %w", err)
+	}
+
+	resp, err := c.httpClient.Post(c.endpoint+"/query", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query OSV for %s@%s: %w", module, version, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status from OSV for %s@%s: %s", module, version, resp.Status)
+	}
+
+	var result struct {
+		Vulns []Vulnerability `json:"vulns"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode OSV response: %w", err)
+	}
+
+	return result.Vulns, nil
+}
+
+// FixedVersion returns the first fixed version listed for the given package
+func (v *Vulnerability) FixedVersion(module string) string {
+	for _, affected := range v.Affected {
+		if affected.Package.Name != module {
+			continue
+		}
+		for _, r := range affected.Ranges {
+			for _, event := range r.Events {
+				if event.Fixed != "" {
+					return event.Fixed
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// CVE returns the CVE identifier of the advisory, if it has one
+func (v *Vulnerability) CVE() string {
+	if strings.HasPrefix(v.ID, "CVE-") {
+		return v.ID
+	}
+	for _, alias := range v.Aliases {
+		if strings.HasPrefix(alias, "CVE-") {
+			return alias
+		}
+	}
+	return ""
+}